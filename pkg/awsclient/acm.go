@@ -0,0 +1,103 @@
+package awsclient
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+)
+
+// ListenerTLSOptions configures an ACM-backed TLS listener for
+// CreateTLSListenerForNLB.
+type ListenerTLSOptions struct {
+	CertificateArn string
+	// SslPolicy is an ELB security policy name, e.g.
+	// "ELBSecurityPolicy-TLS-1-2-2017-01". Defaults to
+	// defaultSslPolicy when empty.
+	SslPolicy string
+}
+
+const defaultSslPolicy = "ELBSecurityPolicy-TLS-1-2-2017-01"
+
+// CreateTLSListenerForNLB creates a TLS listener on the given NLB,
+// terminating TLS with the ACM certificate in opts and forwarding
+// plaintext to the given target group.
+func (c *AwsClient) CreateTLSListenerForNLB(targetGroupArn, lbArn string, opts ListenerTLSOptions) error {
+	if opts.CertificateArn == "" {
+		return fmt.Errorf("createTLSListenerForNLB: CertificateArn is required")
+	}
+	sslPolicy := opts.SslPolicy
+	if sslPolicy == "" {
+		sslPolicy = defaultSslPolicy
+	}
+
+	_, err := c.CreateListenerV2(&elbv2.CreateListenerInput{
+		DefaultActions: []*elbv2.Action{
+			{
+				TargetGroupArn: aws.String(targetGroupArn),
+				Type:           aws.String(elbv2.ActionTypeEnumForward),
+			},
+		},
+		Certificates: []*elbv2.Certificate{
+			{CertificateArn: aws.String(opts.CertificateArn)},
+		},
+		SslPolicy:       aws.String(sslPolicy),
+		LoadBalancerArn: aws.String(lbArn),
+		Port:            aws.Int64(6443),
+		Protocol:        aws.String(elbv2.ProtocolEnumTls),
+	})
+	return err
+}
+
+// AddListenerCertificatesV2 attaches an additional ACM certificate to an
+// existing listener, allowing certificate rotation without recreating the
+// NLB.
+func (c *AwsClient) AddListenerCertificatesV2(i *elbv2.AddListenerCertificatesInput) (*elbv2.AddListenerCertificatesOutput, error) {
+	return c.elbv2Client.AddListenerCertificates(i)
+}
+
+// RemoveListenerCertificatesV2 detaches an ACM certificate from an existing
+// listener.
+func (c *AwsClient) RemoveListenerCertificatesV2(i *elbv2.RemoveListenerCertificatesInput) (*elbv2.RemoveListenerCertificatesOutput, error) {
+	return c.elbv2Client.RemoveListenerCertificates(i)
+}
+
+// ModifyListenerV2 updates an existing listener, e.g. to swap its default
+// certificate or SslPolicy.
+func (c *AwsClient) ModifyListenerV2(i *elbv2.ModifyListenerInput) (*elbv2.ModifyListenerOutput, error) {
+	return c.elbv2Client.ModifyListener(i)
+}
+
+// DescribeCertificate returns the details of a single ACM certificate.
+func (c *AwsClient) DescribeCertificate(i *acm.DescribeCertificateInput) (*acm.DescribeCertificateOutput, error) {
+	return c.acmClient.DescribeCertificate(i)
+}
+
+// ListCertificates lists ACM certificates visible to this account/region.
+func (c *AwsClient) ListCertificates(i *acm.ListCertificatesInput) (*acm.ListCertificatesOutput, error) {
+	return c.acmClient.ListCertificates(i)
+}
+
+// FindCertificateArnByDomainName resolves an ACM certificate's ARN by its
+// domain name, so callers can rotate certificates without hard-coding an
+// ARN.
+func (c *AwsClient) FindCertificateArnByDomainName(domainName string) (string, error) {
+	var arn string
+	err := c.acmClient.ListCertificatesPages(&acm.ListCertificatesInput{}, func(out *acm.ListCertificatesOutput, lastPage bool) bool {
+		for _, summary := range out.CertificateSummaryList {
+			if aws.StringValue(summary.DomainName) == domainName {
+				arn = aws.StringValue(summary.CertificateArn)
+				return false
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return "", err
+	}
+	if arn == "" {
+		return "", fmt.Errorf("findCertificateArnByDomainName: no ACM certificate found for domain %s", domainName)
+	}
+	return arn, nil
+}
@@ -0,0 +1,46 @@
+package awsclient
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/aws/aws-sdk-go/service/acm/acmiface"
+)
+
+// fakeACM is a partial fake of acmiface.ACMAPI.
+type fakeACM struct {
+	acmiface.ACMAPI
+
+	summaries []*acm.CertificateSummary
+}
+
+func (f *fakeACM) ListCertificatesPages(in *acm.ListCertificatesInput, fn func(*acm.ListCertificatesOutput, bool) bool) error {
+	fn(&acm.ListCertificatesOutput{CertificateSummaryList: f.summaries}, true)
+	return nil
+}
+
+func TestFindCertificateArnByDomainName(t *testing.T) {
+	fake := &fakeACM{summaries: []*acm.CertificateSummary{
+		{DomainName: aws.String("api.unrelated.example.com"), CertificateArn: aws.String("arn:aws:acm:cert/unrelated")},
+		{DomainName: aws.String("rh-api.cluster.example.com"), CertificateArn: aws.String("arn:aws:acm:cert/rh-api")},
+	}}
+	c := &AwsClient{acmClient: fake}
+
+	arn, err := c.FindCertificateArnByDomainName("rh-api.cluster.example.com")
+	if err != nil {
+		t.Fatalf("FindCertificateArnByDomainName returned error: %v", err)
+	}
+	if arn != "arn:aws:acm:cert/rh-api" {
+		t.Errorf("expected rh-api cert ARN, got %s", arn)
+	}
+}
+
+func TestFindCertificateArnByDomainNameNotFound(t *testing.T) {
+	fake := &fakeACM{}
+	c := &AwsClient{acmClient: fake}
+
+	if _, err := c.FindCertificateArnByDomainName("missing.example.com"); err == nil {
+		t.Fatal("expected error when no certificate matches the domain name")
+	}
+}
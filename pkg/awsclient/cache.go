@@ -0,0 +1,64 @@
+package awsclient
+
+import (
+	"sync"
+	"time"
+)
+
+const defaultCacheTTL = 30 * time.Second
+
+// ttlCache is a small, TTL-keyed cache used to absorb repeated reconciles
+// that ask the same question (e.g. "what are all the NLBs?") within a
+// short window, matching the caching approach used in upstream AWS
+// cloud-provider's DescribeInstances.
+type ttlCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[string]cacheEntry
+}
+
+type cacheEntry struct {
+	value     interface{}
+	expiresAt time.Time
+}
+
+func newTTLCache(ttl time.Duration) *ttlCache {
+	if ttl == 0 {
+		ttl = defaultCacheTTL
+	}
+	return &ttlCache{
+		ttl:     ttl,
+		entries: map[string]cacheEntry{},
+	}
+}
+
+func (c *ttlCache) get(key string) (interface{}, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok || time.Now().After(entry.expiresAt) {
+		return nil, false
+	}
+	return entry.value, true
+}
+
+func (c *ttlCache) set(key string, value interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[key] = cacheEntry{
+		value:     value,
+		expiresAt: time.Now().Add(c.ttl),
+	}
+}
+
+// invalidate drops key from the cache, so the next get forces a fresh
+// lookup. Used after mutations (e.g. creating or deleting an NLB) that
+// would otherwise leave a cached list read stale for up to the cache's TTL.
+func (c *ttlCache) invalidate(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.entries, key)
+}
@@ -1,21 +1,24 @@
 package awsclient
 
-// TODO: Retry upon API failure
-
 import (
 	"context"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
 	"github.com/aws/aws-sdk-go/aws/session"
 
+	"github.com/aws/aws-sdk-go/service/acm"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/elb"
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/sts"
 
+	"github.com/aws/aws-sdk-go/service/acm/acmiface"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	"github.com/aws/aws-sdk-go/service/elb/elbiface"
 	"github.com/aws/aws-sdk-go/service/elbv2/elbv2iface"
@@ -39,6 +42,26 @@ type NewAwsClientInput struct {
 	AwsRegion               string
 	SecretName              string
 	NameSpace               string
+
+	// MaxRetries is the number of times a throttled/5xx/RequestError call is
+	// retried before giving up. Defaults to defaultMaxRetries when zero.
+	MaxRetries int
+	// RetryBaseDelay is the starting delay used by the exponential backoff.
+	// Defaults to defaultRetryBaseDelay when zero.
+	RetryBaseDelay time.Duration
+	// RetryMaxDelay caps the exponential backoff delay. Defaults to
+	// defaultRetryMaxDelay when zero.
+	RetryMaxDelay time.Duration
+
+	// RoleARN, if set, is assumed on top of the base credentials (static,
+	// Secret-sourced, or IRSA) via stscreds.AssumeRoleProvider.
+	RoleARN string
+	// ExternalID is passed along with RoleARN when assuming the role.
+	ExternalID string
+
+	// CacheTTL controls how long ListAllNLBs/SubnetNameToSubnetIDLookup
+	// results are cached for. Defaults to defaultCacheTTL when zero.
+	CacheTTL time.Duration
 }
 
 // Client wraps for AWS SDK (for easier testing)
@@ -53,6 +76,8 @@ type Client interface {
 
 	// list all or 1 NLB to get external or internal
 	DescribeLoadBalancersV2(*elbv2.DescribeLoadBalancersInput) (*elbv2.DescribeLoadBalancersOutput, error)
+	// paginated DescribeLoadBalancersV2, for accounts with hundreds of LBs
+	DescribeLoadBalancersPagesV2(*elbv2.DescribeLoadBalancersInput, func(*elbv2.DescribeLoadBalancersOutput, bool) bool) error
 	// delete external NLB so we can make cluster private
 	DeleteLoadBalancerV2(*elbv2.DeleteLoadBalancerInput) (*elbv2.DeleteLoadBalancerOutput, error)
 	// create nlb to make server api public
@@ -67,6 +92,12 @@ type Client interface {
 	DescribeTargetGroupsV2(*elbv2.DescribeTargetGroupsInput) (*elbv2.DescribeTargetGroupsOutput, error)
 	// add tags for an NLB
 	AddTagsV2(*elbv2.AddTagsInput) (*elbv2.AddTagsOutput, error)
+	// set load balancer attributes (cross-zone, access logs, deletion protection) on an NLB
+	ModifyLoadBalancerAttributesV2(*elbv2.ModifyLoadBalancerAttributesInput) (*elbv2.ModifyLoadBalancerAttributesOutput, error)
+	// reconcile an NLB's subnets in place instead of recreating the NLB
+	SetSubnetsV2(string, []string) (*elbv2.SetSubnetsOutput, error)
+	// reconcile an NLB's security groups in place (newer NLBs only)
+	SetSecurityGroupsV2(string, []string) (*elbv2.SetSecurityGroupsOutput, error)
 
 	/*
 	 * Route 53-related Functions
@@ -77,27 +108,42 @@ type Client interface {
 	ChangeResourceRecordSets(*route53.ChangeResourceRecordSetsInput) (*route53.ChangeResourceRecordSetsOutput, error)
 	// to turn baseDomain into a Route53 zone ID
 	ListHostedZonesByName(*route53.ListHostedZonesByNameInput) (*route53.ListHostedZonesByNameOutput, error)
+	// to find the private hosted zone associated with a VPC
+	ListHostedZonesByVPC(*route53.ListHostedZonesByVPCInput) (*route53.ListHostedZonesByVPCOutput, error)
 
 	/*
 	 * EC2-related Functions
 	 */
 	// DescribeSubnets to find subnet for master nodes for incoming elb
 	DescribeSubnets(*ec2.DescribeSubnetsInput) (*ec2.DescribeSubnetsOutput, error)
+	// paginated DescribeSubnets, for VPCs with large subnet counts
+	DescribeSubnetsPages(*ec2.DescribeSubnetsInput, func(*ec2.DescribeSubnetsOutput, bool) bool) error
 
 	// Helper extensions
 	// ec2
-	SubnetNameToSubnetIDLookup([]string) ([]string, error)
+	SubnetNameToSubnetIDLookup([]string, []*ec2.Filter) ([]string, error)
 
 	// elb/elbv2
 	DoesELBExist(string) (bool, *AWSLoadBalancer, error)
 	ListAllNLBs() ([]LoadBalancerV2, error)
 	DeleteExternalLoadBalancer(string) error
 	CreateNetworkLoadBalancer(string, string, string) ([]LoadBalancerV2, error)
+	CreateNetworkLoadBalancerWithAttributes(string, string, string, *NLBAttributes) ([]LoadBalancerV2, error)
 	CreateListenerForNLB(string, string) error
+	CreateTLSListenerForNLB(string, string, ListenerTLSOptions) error
+	AddListenerCertificatesV2(*elbv2.AddListenerCertificatesInput) (*elbv2.AddListenerCertificatesOutput, error)
+	RemoveListenerCertificatesV2(*elbv2.RemoveListenerCertificatesInput) (*elbv2.RemoveListenerCertificatesOutput, error)
+	ModifyListenerV2(*elbv2.ModifyListenerInput) (*elbv2.ModifyListenerOutput, error)
 	GetTargetGroupArn(string) (string, error)
 
+	// acm
+	DescribeCertificate(*acm.DescribeCertificateInput) (*acm.DescribeCertificateOutput, error)
+	ListCertificates(*acm.ListCertificatesInput) (*acm.ListCertificatesOutput, error)
+	FindCertificateArnByDomainName(string) (string, error)
+
 	// route53
 	UpsertARecord(string, string, string, string, string, bool) error
+	UpsertARecordWithOptions(string, string, RecordOptions) error
 }
 
 type AwsClient struct {
@@ -105,25 +151,99 @@ type AwsClient struct {
 	route53Client route53iface.Route53API
 	elbClient     elbiface.ELBAPI
 	elbv2Client   elbv2iface.ELBV2API
+	acmClient     acmiface.ACMAPI
+	region        string
+	cache         *ttlCache
 }
 
-func NewClient(accessID, accessSecret, token, region string) (*AwsClient, error) {
-	awsConfig := &aws.Config{Region: aws.String(region)}
-	if token == "" {
-		os.Setenv("AWS_ACCESS_KEY_ID", accessID)
-		os.Setenv("AWS_SECRET_ACCESS_KEY", accessSecret)
-	} else {
-		awsConfig.Credentials = credentials.NewStaticCredentials(accessID, accessSecret, token)
+// NewClient builds an AwsClient for the given region and credentials. Every
+// method on the resulting Client automatically retries throttling/5xx/
+// RequestError failures with exponential backoff + jitter (tunable via
+// input.MaxRetries/RetryBaseDelay/RetryMaxDelay), and records Prometheus
+// request metrics, with no call-site changes required.
+//
+// Credentials are resolved as: static accessID/accessSecret/token if given,
+// falling back to credentials.NewEnvCredentials when none are. If
+// input.RoleARN is set, it is assumed on top of whichever base credentials
+// were resolved. The resulting credentials auto-refresh; callers never need
+// to re-login.
+func NewClient(accessID, accessSecret, token string, input NewAwsClientInput) (*AwsClient, error) {
+	awsConfig := &aws.Config{
+		Region:  aws.String(input.AwsRegion),
+		Retryer: newRetryer(input),
+	}
+
+	var baseCreds *credentials.Credentials
+	switch {
+	case accessID != "" || accessSecret != "":
+		baseCreds = credentials.NewStaticCredentials(accessID, accessSecret, token)
+	default:
+		baseCreds = credentials.NewEnvCredentials()
 	}
+	awsConfig.Credentials = baseCreds
+
 	s, err := session.NewSession(awsConfig)
 	if err != nil {
 		return nil, err
 	}
+
+	if input.RoleARN != "" {
+		s.Config.Credentials = stscreds.NewCredentials(s, input.RoleARN, func(p *stscreds.AssumeRoleProvider) {
+			if input.ExternalID != "" {
+				p.ExternalID = aws.String(input.ExternalID)
+			}
+		})
+	}
+
+	s.Handlers.Complete.PushFront(recordAwsMetric)
 	return &AwsClient{
 		ec2Client:     ec2.New(s),
 		elbClient:     elb.New(s),
 		elbv2Client:   elbv2.New(s),
 		route53Client: route53.New(s),
+		acmClient:     acm.New(s),
+		region:        input.AwsRegion,
+		cache:         newTTLCache(input.CacheTTL),
+	}, nil
+}
+
+// newIRSAClient builds an AwsClient whose credentials come from the
+// projected service-account token at AWS_WEB_IDENTITY_TOKEN_FILE, mirroring
+// how the upstream Kubernetes AWS cloud provider builds its credential
+// chain. The resulting credentials auto-refresh as the token is rotated.
+func newIRSAClient(input NewAwsClientInput) (*AwsClient, error) {
+	awsConfig := &aws.Config{
+		Region:  aws.String(input.AwsRegion),
+		Retryer: newRetryer(input),
+	}
+	s, err := session.NewSession(awsConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenFile := os.Getenv("AWS_WEB_IDENTITY_TOKEN_FILE")
+
+	s.Config.Credentials = credentials.NewCredentials(stscreds.NewWebIdentityRoleProvider(
+		sts.New(s), os.Getenv("AWS_ROLE_ARN"), "cloud-ingress-operator", tokenFile,
+	))
+
+	if input.RoleARN != "" {
+		s.Config.Credentials = stscreds.NewCredentials(s, input.RoleARN, func(p *stscreds.AssumeRoleProvider) {
+			if input.ExternalID != "" {
+				p.ExternalID = aws.String(input.ExternalID)
+			}
+		})
+	}
+
+	s.Handlers.Complete.PushFront(recordAwsMetric)
+	return &AwsClient{
+		ec2Client:     ec2.New(s),
+		elbClient:     elb.New(s),
+		elbv2Client:   elbv2.New(s),
+		route53Client: route53.New(s),
+		acmClient:     acm.New(s),
+		region:        input.AwsRegion,
+		cache:         newTTLCache(input.CacheTTL),
 	}, nil
 }
 
@@ -132,6 +252,12 @@ func NewClient(accessID, accessSecret, token, region string) (*AwsClient, error)
 // Pass in token if sessions requires a token
 // if it includes a secretName and nameSpace it will create credentials from that secret data
 // If it includes awsCredsSecretIDKey and awsCredsSecretAccessKey it will build credentials from those
+//
+// Credential source precedence is IRSA (when AWS_WEB_IDENTITY_TOKEN_FILE is
+// set in the environment), then the Secret, then static access-key/secret.
+//
+// On non-AWS platforms, callers should use cloudclient.GetCloudClient instead, which
+// dispatches to this factory (or the GCP/Azure equivalents) based on the detected platform.
 func GetAWSClient(kubeClient kubeclientpkg.Client, input NewAwsClientInput) (*AwsClient, error) {
 
 	// error if region is not included
@@ -139,6 +265,10 @@ func GetAWSClient(kubeClient kubeclientpkg.Client, input NewAwsClientInput) (*Aw
 		return nil, fmt.Errorf("getAWSClient:NoRegion: %v", input.AwsRegion)
 	}
 
+	if os.Getenv("AWS_WEB_IDENTITY_TOKEN_FILE") != "" {
+		return newIRSAClient(input)
+	}
+
 	if input.SecretName != "" && input.NameSpace != "" {
 		secret := &corev1.Secret{}
 		err := kubeClient.Get(context.TODO(),
@@ -161,7 +291,7 @@ func GetAWSClient(kubeClient kubeclientpkg.Client, input NewAwsClientInput) (*Aw
 				input.SecretName, awsCredsSecretAccessKey)
 		}
 
-		AwsClient, err := NewClient(string(accessKeyID), string(secretAccessKey), input.AwsToken, input.AwsRegion)
+		AwsClient, err := NewClient(string(accessKeyID), string(secretAccessKey), input.AwsToken, input)
 		if err != nil {
 			return nil, err
 		}
@@ -172,7 +302,7 @@ func GetAWSClient(kubeClient kubeclientpkg.Client, input NewAwsClientInput) (*Aw
 		return nil, fmt.Errorf("getAWSClient: NoAwsCredentials or Secret %v", input)
 	}
 
-	AwsClient, err := NewClient(input.AwsCredsSecretIDKey, input.AwsCredsSecretAccessKey, input.AwsToken, input.AwsRegion)
+	AwsClient, err := NewClient(input.AwsCredsSecretIDKey, input.AwsCredsSecretAccessKey, input.AwsToken, input)
 	if err != nil {
 		return nil, err
 	}
@@ -210,6 +340,10 @@ func (c *AwsClient) DescribeLoadBalancersV2(i *elbv2.DescribeLoadBalancersInput)
 	return c.elbv2Client.DescribeLoadBalancers(i)
 }
 
+func (c *AwsClient) DescribeLoadBalancersPagesV2(i *elbv2.DescribeLoadBalancersInput, fn func(*elbv2.DescribeLoadBalancersOutput, bool) bool) error {
+	return c.elbv2Client.DescribeLoadBalancersPages(i, fn)
+}
+
 func (c *AwsClient) DeleteLoadBalancerV2(i *elbv2.DeleteLoadBalancerInput) (*elbv2.DeleteLoadBalancerOutput, error) {
 	return c.elbv2Client.DeleteLoadBalancer(i)
 }
@@ -251,6 +385,9 @@ func (c *AwsClient) ChangeResourceRecordSets(i *route53.ChangeResourceRecordSets
 func (c *AwsClient) ListHostedZonesByName(i *route53.ListHostedZonesByNameInput) (*route53.ListHostedZonesByNameOutput, error) {
 	return c.route53Client.ListHostedZonesByName(i)
 }
+func (c *AwsClient) ListHostedZonesByVPC(i *route53.ListHostedZonesByVPCInput) (*route53.ListHostedZonesByVPCOutput, error) {
+	return c.route53Client.ListHostedZonesByVPC(i)
+}
 
 func (c *AwsClient) AuthorizeSecurityGroupIngress(i *ec2.AuthorizeSecurityGroupIngressInput) (*ec2.AuthorizeSecurityGroupIngressOutput, error) {
 	return c.ec2Client.AuthorizeSecurityGroupIngress(i)
@@ -270,6 +407,9 @@ func (c *AwsClient) RevokeSecurityGroupIngress(i *ec2.RevokeSecurityGroupIngress
 func (c *AwsClient) DescribeSubnets(i *ec2.DescribeSubnetsInput) (*ec2.DescribeSubnetsOutput, error) {
 	return c.ec2Client.DescribeSubnets(i)
 }
+func (c *AwsClient) DescribeSubnetsPages(i *ec2.DescribeSubnetsInput, fn func(*ec2.DescribeSubnetsOutput, bool) bool) error {
+	return c.ec2Client.DescribeSubnetsPages(i, fn)
+}
 func (c *AwsClient) CreateTags(i *ec2.CreateTagsInput) (*ec2.CreateTagsOutput, error) {
 	return c.ec2Client.CreateTags(i)
 }
@@ -0,0 +1,133 @@
+package awsclient
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+)
+
+// RecordOptions controls how UpsertARecordWithOptions writes a record: as a
+// plain A record or an ALIAS pointing at an NLB, and into the public zone
+// or a private zone selected by VPC association.
+type RecordOptions struct {
+	// Alias, when true, writes an ALIAS A record pointing at
+	// TargetHostedZoneID/TargetDNSName instead of a plain A record with an
+	// IP target.
+	Alias              bool
+	TargetHostedZoneID string
+	TargetDNSName      string
+
+	// PrivateZoneVPCID, when set, selects the private hosted zone
+	// associated with this VPC instead of the public hosted zone for
+	// baseDomain. Required for PrivateLink clusters, where the public zone
+	// cannot be used.
+	PrivateZoneVPCID string
+}
+
+// UpsertARecord creates or updates an A record for dnsName within the public
+// hosted zone for baseDomain: a plain A record with target as its value, or,
+// when alias is true, an ALIAS record pointing at target in
+// targetHostedZoneID. recordSetName is unused. Kept for existing callers;
+// new callers needing private-zone selection should use
+// UpsertARecordWithOptions.
+func (c *AwsClient) UpsertARecord(baseDomain, target, recordSetName, targetHostedZoneID, dnsName string, alias bool) error {
+	zoneID, err := c.hostedZoneIDForName(baseDomain)
+	if err != nil {
+		return err
+	}
+	return c.changeARecord(zoneID, dnsName, RecordOptions{
+		Alias:              alias,
+		TargetHostedZoneID: targetHostedZoneID,
+		TargetDNSName:      target,
+	})
+}
+
+// UpsertARecordWithOptions creates or updates an A record for dnsName
+// within the hosted zone for baseDomain, as either a plain A record or an
+// ALIAS record, in either the public zone or a private zone selected by
+// opts.PrivateZoneVPCID. This is how split-horizon DNS is managed for
+// PrivateLink clusters where the public zone cannot be used.
+func (c *AwsClient) UpsertARecordWithOptions(baseDomain, dnsName string, opts RecordOptions) error {
+	var zoneID string
+	var err error
+	if opts.PrivateZoneVPCID != "" {
+		zoneID, err = c.privateHostedZoneIDForVPC(baseDomain, opts.PrivateZoneVPCID)
+	} else {
+		zoneID, err = c.hostedZoneIDForName(baseDomain)
+	}
+	if err != nil {
+		return err
+	}
+	return c.changeARecord(zoneID, dnsName, opts)
+}
+
+func (c *AwsClient) changeARecord(zoneID, dnsName string, opts RecordOptions) error {
+	rrset := &route53.ResourceRecordSet{
+		Name: aws.String(dnsName),
+		Type: aws.String(route53.RRTypeA),
+	}
+	if opts.Alias {
+		rrset.AliasTarget = &route53.AliasTarget{
+			HostedZoneId:         aws.String(opts.TargetHostedZoneID),
+			DNSName:              aws.String(opts.TargetDNSName),
+			EvaluateTargetHealth: aws.Bool(false),
+		}
+	} else {
+		rrset.TTL = aws.Int64(300)
+		rrset.ResourceRecords = []*route53.ResourceRecord{
+			{Value: aws.String(opts.TargetDNSName)},
+		}
+	}
+
+	_, err := c.ChangeResourceRecordSets(&route53.ChangeResourceRecordSetsInput{
+		HostedZoneId: aws.String(zoneID),
+		ChangeBatch: &route53.ChangeBatch{
+			Changes: []*route53.Change{
+				{
+					Action:            aws.String(route53.ChangeActionUpsert),
+					ResourceRecordSet: rrset,
+				},
+			},
+		},
+	})
+	return err
+}
+
+// hostedZoneIDForName resolves baseDomain to its public hosted zone ID.
+// ListHostedZonesByName doesn't filter for an exact match: it returns zones
+// sorted starting at-or-after DNSName, so the first result must be checked
+// against baseDomain rather than trusted outright, or a domain with no zone
+// of its own could silently resolve to the next zone alphabetically.
+func (c *AwsClient) hostedZoneIDForName(baseDomain string) (string, error) {
+	out, err := c.ListHostedZonesByName(&route53.ListHostedZonesByNameInput{
+		DNSName: aws.String(baseDomain),
+	})
+	if err != nil {
+		return "", err
+	}
+	if len(out.HostedZones) == 0 || !strings.EqualFold(strings.TrimSuffix(aws.StringValue(out.HostedZones[0].Name), "."), strings.TrimSuffix(baseDomain, ".")) {
+		return "", fmt.Errorf("hostedZoneIDForName: no hosted zone found for %s", baseDomain)
+	}
+	return aws.StringValue(out.HostedZones[0].Id), nil
+}
+
+// privateHostedZoneIDForVPC finds the private hosted zone for baseDomain
+// that is associated with vpcID, since a domain name alone can match both a
+// public and a private zone.
+func (c *AwsClient) privateHostedZoneIDForVPC(baseDomain, vpcID string) (string, error) {
+	out, err := c.ListHostedZonesByVPC(&route53.ListHostedZonesByVPCInput{
+		VPCId:     aws.String(vpcID),
+		VPCRegion: aws.String(c.region),
+	})
+	if err != nil {
+		return "", err
+	}
+	for _, zone := range out.HostedZoneSummaries {
+		if strings.EqualFold(strings.TrimSuffix(aws.StringValue(zone.Name), "."), strings.TrimSuffix(baseDomain, ".")) {
+			return aws.StringValue(zone.HostedZoneId), nil
+		}
+	}
+	return "", fmt.Errorf("privateHostedZoneIDForVPC: no private hosted zone for %s associated with VPC %s", baseDomain, vpcID)
+}
@@ -0,0 +1,103 @@
+package awsclient
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/route53/route53iface"
+)
+
+// fakeRoute53 is a partial fake of route53iface.Route53API.
+type fakeRoute53 struct {
+	route53iface.Route53API
+
+	publicZones  []*route53.HostedZone
+	privateZones []*route53.HostedZoneSummary
+
+	lastChange *route53.ChangeResourceRecordSetsInput
+}
+
+func (f *fakeRoute53) ListHostedZonesByName(*route53.ListHostedZonesByNameInput) (*route53.ListHostedZonesByNameOutput, error) {
+	return &route53.ListHostedZonesByNameOutput{HostedZones: f.publicZones}, nil
+}
+
+func (f *fakeRoute53) ListHostedZonesByVPC(*route53.ListHostedZonesByVPCInput) (*route53.ListHostedZonesByVPCOutput, error) {
+	return &route53.ListHostedZonesByVPCOutput{HostedZoneSummaries: f.privateZones}, nil
+}
+
+func (f *fakeRoute53) ChangeResourceRecordSets(i *route53.ChangeResourceRecordSetsInput) (*route53.ChangeResourceRecordSetsOutput, error) {
+	f.lastChange = i
+	return &route53.ChangeResourceRecordSetsOutput{}, nil
+}
+
+func TestUpsertARecordWithOptionsAlias(t *testing.T) {
+	fake := &fakeRoute53{
+		publicZones: []*route53.HostedZone{
+			{Id: aws.String("/hostedzone/PUBLICZONE"), Name: aws.String("cluster.example.com.")},
+		},
+	}
+	c := &AwsClient{route53Client: fake}
+
+	err := c.UpsertARecordWithOptions("cluster.example.com.", "rh-api.cluster.example.com.", RecordOptions{
+		Alias:              true,
+		TargetHostedZoneID: "Z215JYRZR1TBD5",
+		TargetDNSName:      "rh-api-nlb.example.com.",
+	})
+	if err != nil {
+		t.Fatalf("UpsertARecordWithOptions returned error: %v", err)
+	}
+
+	rrset := fake.lastChange.ChangeBatch.Changes[0].ResourceRecordSet
+	if rrset.AliasTarget == nil {
+		t.Fatal("expected an ALIAS target to be set")
+	}
+	if aws.StringValue(rrset.AliasTarget.DNSName) != "rh-api-nlb.example.com." {
+		t.Errorf("unexpected alias target DNS name: %s", aws.StringValue(rrset.AliasTarget.DNSName))
+	}
+	if aws.StringValue(fake.lastChange.HostedZoneId) != "/hostedzone/PUBLICZONE" {
+		t.Errorf("expected the public zone to be used, got %s", aws.StringValue(fake.lastChange.HostedZoneId))
+	}
+}
+
+func TestUpsertARecordWithOptionsPrivateZone(t *testing.T) {
+	fake := &fakeRoute53{
+		privateZones: []*route53.HostedZoneSummary{
+			{HostedZoneId: aws.String("/hostedzone/PRIVATEZONE"), Name: aws.String("cluster.example.com.")},
+		},
+	}
+	c := &AwsClient{route53Client: fake}
+
+	err := c.UpsertARecordWithOptions("cluster.example.com.", "rh-api.cluster.example.com.", RecordOptions{
+		TargetDNSName:    "10.0.0.5",
+		PrivateZoneVPCID: "vpc-12345",
+	})
+	if err != nil {
+		t.Fatalf("UpsertARecordWithOptions returned error: %v", err)
+	}
+
+	if aws.StringValue(fake.lastChange.HostedZoneId) != "/hostedzone/PRIVATEZONE" {
+		t.Errorf("expected the private zone to be used, got %s", aws.StringValue(fake.lastChange.HostedZoneId))
+	}
+}
+
+func TestUpsertARecordWithOptionsPrivateZoneMatchesBaseDomainWithoutTrailingDot(t *testing.T) {
+	fake := &fakeRoute53{
+		privateZones: []*route53.HostedZoneSummary{
+			{HostedZoneId: aws.String("/hostedzone/PRIVATEZONE"), Name: aws.String("cluster.example.com.")},
+		},
+	}
+	c := &AwsClient{route53Client: fake}
+
+	err := c.UpsertARecordWithOptions("cluster.example.com", "rh-api.cluster.example.com.", RecordOptions{
+		TargetDNSName:    "10.0.0.5",
+		PrivateZoneVPCID: "vpc-12345",
+	})
+	if err != nil {
+		t.Fatalf("UpsertARecordWithOptions returned error: %v", err)
+	}
+
+	if aws.StringValue(fake.lastChange.HostedZoneId) != "/hostedzone/PRIVATEZONE" {
+		t.Errorf("expected the private zone to be used, got %s", aws.StringValue(fake.lastChange.HostedZoneId))
+	}
+}
@@ -0,0 +1,74 @@
+package awsclient
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+// SubnetNameToSubnetIDLookup resolves a list of "Name" tag values to their
+// corresponding subnet IDs, applying filters server-side (via the ec2
+// paginator) rather than fetching every subnet in the VPC and filtering in
+// Go. Results are cached for c.cache's TTL (default 30s) to absorb repeated
+// reconciles.
+func (c *AwsClient) SubnetNameToSubnetIDLookup(names []string, filters []*ec2.Filter) ([]string, error) {
+	input := &ec2.DescribeSubnetsInput{
+		Filters: append([]*ec2.Filter{
+			{
+				Name:   aws.String("tag:Name"),
+				Values: aws.StringSlice(names),
+			},
+		}, filters...),
+	}
+
+	cacheKey := subnetLookupCacheKey(names, filters)
+	if cached, ok := c.cache.get(cacheKey); ok {
+		return cached.([]string), nil
+	}
+
+	found := make(map[string]bool, len(names))
+	var ids []string
+	err := c.DescribeSubnetsPages(input, func(out *ec2.DescribeSubnetsOutput, lastPage bool) bool {
+		for _, subnet := range out.Subnets {
+			for _, tag := range subnet.Tags {
+				if aws.StringValue(tag.Key) == "Name" {
+					found[aws.StringValue(tag.Value)] = true
+				}
+			}
+			ids = append(ids, aws.StringValue(subnet.SubnetId))
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, name := range names {
+		if !found[name] {
+			return nil, fmt.Errorf("subnetNameToSubnetIDLookup: no subnet found with Name tag %q", name)
+		}
+	}
+
+	c.cache.set(cacheKey, ids)
+	return ids, nil
+}
+
+// subnetLookupCacheKey builds a stable cache key from the lookup's inputs,
+// sorting names, filters and filter values so their order does not matter.
+func subnetLookupCacheKey(names []string, filters []*ec2.Filter) string {
+	sortedNames := append([]string{}, names...)
+	sort.Strings(sortedNames)
+
+	var filterParts []string
+	for _, f := range filters {
+		values := aws.StringValueSlice(f.Values)
+		sort.Strings(values)
+		filterParts = append(filterParts, fmt.Sprintf("%s=%s", aws.StringValue(f.Name), strings.Join(values, ",")))
+	}
+	sort.Strings(filterParts)
+
+	return fmt.Sprintf("SubnetNameToSubnetIDLookup:%s:%s", strings.Join(sortedNames, ","), strings.Join(filterParts, ";"))
+}
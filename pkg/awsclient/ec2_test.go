@@ -0,0 +1,74 @@
+package awsclient
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+)
+
+// fakeEC2 is a partial fake of ec2iface.EC2API.
+type fakeEC2 struct {
+	ec2iface.EC2API
+
+	subnets    []*ec2.Subnet
+	pageCalls  int
+	lastFilter []*ec2.Filter
+}
+
+func (f *fakeEC2) DescribeSubnetsPages(i *ec2.DescribeSubnetsInput, fn func(*ec2.DescribeSubnetsOutput, bool) bool) error {
+	f.pageCalls++
+	f.lastFilter = i.Filters
+	fn(&ec2.DescribeSubnetsOutput{Subnets: f.subnets}, true)
+	return nil
+}
+
+func TestSubnetNameToSubnetIDLookupAppliesServerSideFilters(t *testing.T) {
+	fake := &fakeEC2{
+		subnets: []*ec2.Subnet{
+			{SubnetId: aws.String("subnet-111"), Tags: []*ec2.Tag{{Key: aws.String("Name"), Value: aws.String("subnet-a")}}},
+		},
+	}
+	c := &AwsClient{ec2Client: fake, cache: newTTLCache(0)}
+
+	extraFilter := []*ec2.Filter{{Name: aws.String("vpc-id"), Values: aws.StringSlice([]string{"vpc-1"})}}
+	ids, err := c.SubnetNameToSubnetIDLookup([]string{"subnet-a"}, extraFilter)
+	if err != nil {
+		t.Fatalf("SubnetNameToSubnetIDLookup returned error: %v", err)
+	}
+	if len(ids) != 1 || ids[0] != "subnet-111" {
+		t.Errorf("unexpected ids: %v", ids)
+	}
+	if len(fake.lastFilter) != 2 {
+		t.Errorf("expected both the Name filter and the caller's filter to be applied, got %v", fake.lastFilter)
+	}
+}
+
+func TestSubnetNameToSubnetIDLookupCachesResults(t *testing.T) {
+	fake := &fakeEC2{
+		subnets: []*ec2.Subnet{
+			{SubnetId: aws.String("subnet-111"), Tags: []*ec2.Tag{{Key: aws.String("Name"), Value: aws.String("subnet-a")}}},
+		},
+	}
+	c := &AwsClient{ec2Client: fake, cache: newTTLCache(0)}
+
+	if _, err := c.SubnetNameToSubnetIDLookup([]string{"subnet-a"}, nil); err != nil {
+		t.Fatalf("first lookup returned error: %v", err)
+	}
+	if _, err := c.SubnetNameToSubnetIDLookup([]string{"subnet-a"}, nil); err != nil {
+		t.Fatalf("second lookup returned error: %v", err)
+	}
+
+	if fake.pageCalls != 1 {
+		t.Errorf("expected DescribeSubnetsPages to be called once due to caching, got %d", fake.pageCalls)
+	}
+}
+
+func TestSubnetNameToSubnetIDLookupErrorsOnMissingSubnet(t *testing.T) {
+	c := &AwsClient{ec2Client: &fakeEC2{}, cache: newTTLCache(0)}
+
+	if _, err := c.SubnetNameToSubnetIDLookup([]string{"subnet-missing"}, nil); err == nil {
+		t.Fatal("expected error for a Name tag with no matching subnet")
+	}
+}
@@ -0,0 +1,55 @@
+package awsclient
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var (
+	awsAPIRequestsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "cloud_ingress_aws_api_requests_total",
+			Help: "Number of AWS API requests made by cloud-ingress-operator, by service, operation, and response code.",
+		},
+		[]string{"service", "operation", "code"},
+	)
+
+	awsAPIRequestDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "cloud_ingress_aws_api_request_duration_seconds",
+			Help:    "Latency of AWS API requests made by cloud-ingress-operator, by service and operation.",
+			Buckets: prometheus.DefBuckets,
+		},
+		[]string{"service", "operation"},
+	)
+)
+
+func init() {
+	metrics.Registry.MustRegister(awsAPIRequestsTotal, awsAPIRequestDuration)
+}
+
+// recordAwsMetric is a request.Handlers.Complete handler that records a
+// Prometheus counter and latency histogram for every AWS API call made
+// through this package's clients, mirroring the approach used by the
+// upstream Kubernetes AWS cloud provider's recordAwsMetric.
+func recordAwsMetric(req *request.Request) {
+	service := req.ClientInfo.ServiceName
+	operation := ""
+	if req.Operation != nil {
+		operation = req.Operation.Name
+	}
+
+	code := "0"
+	if req.HTTPResponse != nil {
+		code = strconv.Itoa(req.HTTPResponse.StatusCode)
+	} else if req.Error != nil {
+		code = "error"
+	}
+
+	awsAPIRequestsTotal.WithLabelValues(service, operation, code).Inc()
+	awsAPIRequestDuration.WithLabelValues(service, operation).Observe(time.Since(req.Time).Seconds())
+}
@@ -0,0 +1,269 @@
+package awsclient
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+)
+
+// AWSLoadBalancer is a thin, caller-friendly view of an elbv2.LoadBalancer
+// returned by DoesELBExist.
+type AWSLoadBalancer struct {
+	ResourceName              string
+	DNSName                   string
+	CanonicalHostedZoneNameID string
+	Scheme                    string
+}
+
+// LoadBalancerV2 is a thin, caller-friendly view of an elbv2.LoadBalancer
+// returned by ListAllNLBs/CreateNetworkLoadBalancer.
+type LoadBalancerV2 struct {
+	Name                      string
+	DNSName                   string
+	CanonicalHostedZoneNameID string
+	Scheme                    string
+	LoadBalancerArn           string
+}
+
+// NLBAttributes carries the elbv2 LoadBalancerAttributes cloud-ingress-operator
+// sets on the rh-api NLB at creation time.
+type NLBAttributes struct {
+	CrossZoneLoadBalancingEnabled bool
+	AccessLogsS3Enabled           bool
+	AccessLogsS3Bucket            string
+	AccessLogsS3Prefix            string
+	DeletionProtectionEnabled     bool
+}
+
+func toLoadBalancerV2(lb *elbv2.LoadBalancer) LoadBalancerV2 {
+	v2 := LoadBalancerV2{LoadBalancerArn: aws.StringValue(lb.LoadBalancerArn)}
+	if lb.LoadBalancerName != nil {
+		v2.Name = *lb.LoadBalancerName
+	}
+	if lb.DNSName != nil {
+		v2.DNSName = *lb.DNSName
+	}
+	if lb.CanonicalHostedZoneId != nil {
+		v2.CanonicalHostedZoneNameID = *lb.CanonicalHostedZoneId
+	}
+	if lb.Scheme != nil {
+		v2.Scheme = *lb.Scheme
+	}
+	return v2
+}
+
+// DoesELBExist looks up an NLB by name and reports whether it exists.
+func (c *AwsClient) DoesELBExist(name string) (bool, *AWSLoadBalancer, error) {
+	out, err := c.DescribeLoadBalancersV2(&elbv2.DescribeLoadBalancersInput{
+		Names: []*string{aws.String(name)},
+	})
+	if err != nil {
+		if isELBNotFound(err) {
+			return false, nil, nil
+		}
+		return false, nil, err
+	}
+	if len(out.LoadBalancers) == 0 {
+		return false, nil, nil
+	}
+	lb := out.LoadBalancers[0]
+	return true, &AWSLoadBalancer{
+		ResourceName:              aws.StringValue(lb.LoadBalancerName),
+		DNSName:                   aws.StringValue(lb.DNSName),
+		CanonicalHostedZoneNameID: aws.StringValue(lb.CanonicalHostedZoneId),
+		Scheme:                    aws.StringValue(lb.Scheme),
+	}, nil
+}
+
+const listAllNLBsCacheKey = "ListAllNLBs"
+
+// ListAllNLBs returns every network load balancer in the account/region,
+// using the elbv2 paginator so large accounts don't pay the cost of
+// DescribeLoadBalancers returning everything in one shot. Results are
+// cached for c.cache's TTL (default 30s) to absorb repeated reconciles.
+func (c *AwsClient) ListAllNLBs() ([]LoadBalancerV2, error) {
+	if cached, ok := c.cache.get(listAllNLBsCacheKey); ok {
+		return cached.([]LoadBalancerV2), nil
+	}
+
+	var nlbs []LoadBalancerV2
+	err := c.DescribeLoadBalancersPagesV2(&elbv2.DescribeLoadBalancersInput{}, func(out *elbv2.DescribeLoadBalancersOutput, lastPage bool) bool {
+		for _, lb := range out.LoadBalancers {
+			if aws.StringValue(lb.Type) != elbv2.LoadBalancerTypeEnumNetwork {
+				continue
+			}
+			nlbs = append(nlbs, toLoadBalancerV2(lb))
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	c.cache.set(listAllNLBsCacheKey, nlbs)
+	return nlbs, nil
+}
+
+// DeleteExternalLoadBalancer deletes the external (internet-facing) NLB by
+// name so the cluster can be made private.
+func (c *AwsClient) DeleteExternalLoadBalancer(name string) error {
+	exists, lb, err := c.DoesELBExist(name)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("deleteExternalLoadBalancer: %s not found", name)
+	}
+	out, err := c.DescribeLoadBalancersV2(&elbv2.DescribeLoadBalancersInput{Names: []*string{aws.String(lb.ResourceName)}})
+	if err != nil {
+		return err
+	}
+	if len(out.LoadBalancers) == 0 {
+		return fmt.Errorf("deleteExternalLoadBalancer: %s not found", name)
+	}
+	_, err = c.DeleteLoadBalancerV2(&elbv2.DeleteLoadBalancerInput{
+		LoadBalancerArn: out.LoadBalancers[0].LoadBalancerArn,
+	})
+	if err != nil {
+		return err
+	}
+	c.cache.invalidate(listAllNLBsCacheKey)
+	return nil
+}
+
+// CreateNetworkLoadBalancer creates an NLB with the given name/scheme in the
+// given subnet. It applies no NLBAttributes; CreateNetworkLoadBalancerWithAttributes
+// does that at creation time.
+func (c *AwsClient) CreateNetworkLoadBalancer(name, scheme, subnet string) ([]LoadBalancerV2, error) {
+	return c.CreateNetworkLoadBalancerWithAttributes(name, scheme, subnet, nil)
+}
+
+// CreateNetworkLoadBalancerWithAttributes is CreateNetworkLoadBalancer plus
+// an NLBAttributes options struct for cross-zone load balancing, access
+// logging, and deletion protection.
+func (c *AwsClient) CreateNetworkLoadBalancerWithAttributes(name, scheme, subnet string, attrs *NLBAttributes) ([]LoadBalancerV2, error) {
+	out, err := c.CreateLoadBalancerV2(&elbv2.CreateLoadBalancerInput{
+		Name:    aws.String(name),
+		Scheme:  aws.String(scheme),
+		Type:    aws.String(elbv2.LoadBalancerTypeEnumNetwork),
+		Subnets: []*string{aws.String(subnet)},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	nlbs := make([]LoadBalancerV2, 0, len(out.LoadBalancers))
+	for _, lb := range out.LoadBalancers {
+		nlbs = append(nlbs, toLoadBalancerV2(lb))
+	}
+
+	if attrs != nil && len(out.LoadBalancers) > 0 {
+		if _, err := c.ModifyLoadBalancerAttributesV2(&elbv2.ModifyLoadBalancerAttributesInput{
+			LoadBalancerArn: out.LoadBalancers[0].LoadBalancerArn,
+			Attributes:      nlbAttributesToElbv2(attrs),
+		}); err != nil {
+			return nlbs, fmt.Errorf("createNetworkLoadBalancer: created %s but failed to apply attributes: %w", name, err)
+		}
+	}
+
+	c.cache.invalidate(listAllNLBsCacheKey)
+	return nlbs, nil
+}
+
+func nlbAttributesToElbv2(attrs *NLBAttributes) []*elbv2.LoadBalancerAttribute {
+	out := []*elbv2.LoadBalancerAttribute{
+		{
+			Key:   aws.String("load_balancing.cross_zone.enabled"),
+			Value: aws.String(fmt.Sprintf("%t", attrs.CrossZoneLoadBalancingEnabled)),
+		},
+		{
+			Key:   aws.String("deletion_protection.enabled"),
+			Value: aws.String(fmt.Sprintf("%t", attrs.DeletionProtectionEnabled)),
+		},
+		{
+			Key:   aws.String("access_logs.s3.enabled"),
+			Value: aws.String(fmt.Sprintf("%t", attrs.AccessLogsS3Enabled)),
+		},
+	}
+	if attrs.AccessLogsS3Enabled {
+		out = append(out,
+			&elbv2.LoadBalancerAttribute{Key: aws.String("access_logs.s3.bucket"), Value: aws.String(attrs.AccessLogsS3Bucket)},
+			&elbv2.LoadBalancerAttribute{Key: aws.String("access_logs.s3.prefix"), Value: aws.String(attrs.AccessLogsS3Prefix)},
+		)
+	}
+	return out
+}
+
+// ModifyLoadBalancerAttributesV2 sets NLB attributes such as cross-zone load
+// balancing, access logging, and deletion protection on an existing NLB.
+func (c *AwsClient) ModifyLoadBalancerAttributesV2(i *elbv2.ModifyLoadBalancerAttributesInput) (*elbv2.ModifyLoadBalancerAttributesOutput, error) {
+	return c.elbv2Client.ModifyLoadBalancerAttributes(i)
+}
+
+// SetSubnetsV2 reconciles the subnets attached to an NLB in place, instead
+// of tearing down and recreating the load balancer when the master subnet
+// set changes.
+func (c *AwsClient) SetSubnetsV2(lbArn string, subnetIDs []string) (*elbv2.SetSubnetsOutput, error) {
+	subnets := make([]*string, 0, len(subnetIDs))
+	for _, s := range subnetIDs {
+		s := s
+		subnets = append(subnets, &s)
+	}
+	return c.elbv2Client.SetSubnets(&elbv2.SetSubnetsInput{
+		LoadBalancerArn: aws.String(lbArn),
+		Subnets:         subnets,
+	})
+}
+
+// SetSecurityGroupsV2 reconciles the security groups attached to an NLB in
+// place. Only newer NLBs (those created with a security group already
+// attached) support this; older NLBs require recreation.
+func (c *AwsClient) SetSecurityGroupsV2(lbArn string, securityGroupIDs []string) (*elbv2.SetSecurityGroupsOutput, error) {
+	sgs := make([]*string, 0, len(securityGroupIDs))
+	for _, sg := range securityGroupIDs {
+		sg := sg
+		sgs = append(sgs, &sg)
+	}
+	return c.elbv2Client.SetSecurityGroups(&elbv2.SetSecurityGroupsInput{
+		LoadBalancerArn: aws.String(lbArn),
+		SecurityGroups:  sgs,
+	})
+}
+
+// CreateListenerForNLB creates a plain TCP listener on the given NLB
+// forwarding to the given target group.
+func (c *AwsClient) CreateListenerForNLB(targetGroupArn, lbArn string) error {
+	_, err := c.CreateListenerV2(&elbv2.CreateListenerInput{
+		DefaultActions: []*elbv2.Action{
+			{
+				TargetGroupArn: aws.String(targetGroupArn),
+				Type:           aws.String(elbv2.ActionTypeEnumForward),
+			},
+		},
+		LoadBalancerArn: aws.String(lbArn),
+		Port:            aws.Int64(6443),
+		Protocol:        aws.String(elbv2.ProtocolEnumTcp),
+	})
+	return err
+}
+
+// GetTargetGroupArn resolves a target group's ARN by name.
+func (c *AwsClient) GetTargetGroupArn(name string) (string, error) {
+	out, err := c.DescribeTargetGroupsV2(&elbv2.DescribeTargetGroupsInput{
+		Names: []*string{aws.String(name)},
+	})
+	if err != nil {
+		return "", err
+	}
+	if len(out.TargetGroups) == 0 {
+		return "", fmt.Errorf("getTargetGroupArn: no target group named %s", name)
+	}
+	return aws.StringValue(out.TargetGroups[0].TargetGroupArn), nil
+}
+
+func isELBNotFound(err error) bool {
+	aerr, ok := err.(awserr.Error)
+	return ok && aerr.Code() == elbv2.ErrCodeLoadBalancerNotFoundException
+}
@@ -0,0 +1,106 @@
+package awsclient
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/aws-sdk-go/service/elbv2/elbv2iface"
+)
+
+// fakeELBV2 is a partial fake of elbv2iface.ELBV2API: embedding the
+// interface satisfies it at compile time, and only the methods a test
+// needs are overridden.
+type fakeELBV2 struct {
+	elbv2iface.ELBV2API
+
+	setSubnetsInput *elbv2.SetSubnetsInput
+	loadBalancers   []*elbv2.LoadBalancer
+	pageCalls       int
+}
+
+func (f *fakeELBV2) SetSubnets(i *elbv2.SetSubnetsInput) (*elbv2.SetSubnetsOutput, error) {
+	f.setSubnetsInput = i
+	return &elbv2.SetSubnetsOutput{}, nil
+}
+
+func (f *fakeELBV2) DescribeLoadBalancersPages(i *elbv2.DescribeLoadBalancersInput, fn func(*elbv2.DescribeLoadBalancersOutput, bool) bool) error {
+	f.pageCalls++
+	fn(&elbv2.DescribeLoadBalancersOutput{LoadBalancers: f.loadBalancers}, true)
+	return nil
+}
+
+func TestListAllNLBsFiltersToNetworkTypeAndCaches(t *testing.T) {
+	fake := &fakeELBV2{
+		loadBalancers: []*elbv2.LoadBalancer{
+			{LoadBalancerName: aws.String("rh-api"), Type: aws.String(elbv2.LoadBalancerTypeEnumNetwork)},
+			{LoadBalancerName: aws.String("classic-elb"), Type: aws.String(elbv2.LoadBalancerTypeEnumApplication)},
+		},
+	}
+	c := &AwsClient{elbv2Client: fake, cache: newTTLCache(0)}
+
+	nlbs, err := c.ListAllNLBs()
+	if err != nil {
+		t.Fatalf("ListAllNLBs returned error: %v", err)
+	}
+	if len(nlbs) != 1 || nlbs[0].Name != "rh-api" {
+		t.Errorf("expected only the NLB to be returned, got %v", nlbs)
+	}
+
+	if _, err := c.ListAllNLBs(); err != nil {
+		t.Fatalf("second ListAllNLBs call returned error: %v", err)
+	}
+	if fake.pageCalls != 1 {
+		t.Errorf("expected DescribeLoadBalancersPages to be called once due to caching, got %d", fake.pageCalls)
+	}
+}
+
+func TestSetSubnetsV2ReconcilesSubnetDrift(t *testing.T) {
+	fake := &fakeELBV2{}
+	c := &AwsClient{elbv2Client: fake}
+
+	newSubnets := []string{"subnet-aaa", "subnet-bbb", "subnet-ccc"}
+	if _, err := c.SetSubnetsV2("arn:aws:elasticloadbalancing:nlb/rh-api", newSubnets); err != nil {
+		t.Fatalf("SetSubnetsV2 returned error: %v", err)
+	}
+
+	if fake.setSubnetsInput == nil {
+		t.Fatal("expected SetSubnets to be called")
+	}
+
+	got := make([]string, 0, len(fake.setSubnetsInput.Subnets))
+	for _, s := range fake.setSubnetsInput.Subnets {
+		got = append(got, aws.StringValue(s))
+	}
+	if !reflect.DeepEqual(got, newSubnets) {
+		t.Errorf("expected subnets %v, got %v", newSubnets, got)
+	}
+}
+
+func TestNlbAttributesToElbv2IncludesAccessLogFieldsWhenEnabled(t *testing.T) {
+	attrs := &NLBAttributes{
+		CrossZoneLoadBalancingEnabled: true,
+		AccessLogsS3Enabled:           true,
+		AccessLogsS3Bucket:            "my-bucket",
+		AccessLogsS3Prefix:            "rh-api",
+		DeletionProtectionEnabled:     true,
+	}
+
+	got := nlbAttributesToElbv2(attrs)
+
+	values := map[string]string{}
+	for _, a := range got {
+		values[aws.StringValue(a.Key)] = aws.StringValue(a.Value)
+	}
+
+	if values["load_balancing.cross_zone.enabled"] != "true" {
+		t.Errorf("expected cross-zone enabled, got %v", values)
+	}
+	if values["access_logs.s3.bucket"] != "my-bucket" {
+		t.Errorf("expected access log bucket set, got %v", values)
+	}
+	if values["deletion_protection.enabled"] != "true" {
+		t.Errorf("expected deletion protection enabled, got %v", values)
+	}
+}
@@ -0,0 +1,59 @@
+package awsclient
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+const (
+	defaultMaxRetries     = 5
+	defaultRetryBaseDelay = 300 * time.Millisecond
+	defaultRetryMaxDelay  = 20 * time.Second
+)
+
+// newRetryer builds a request.Retryer that retries throttling, 5xx, and
+// RequestError failures with exponential backoff + jitter, on top of the
+// AWS SDK's default retry classification. Base delay and cap are
+// configurable via input; unset values fall back to the package defaults.
+func newRetryer(input NewAwsClientInput) request.Retryer {
+	maxRetries := input.MaxRetries
+	if maxRetries == 0 {
+		maxRetries = defaultMaxRetries
+	}
+	baseDelay := input.RetryBaseDelay
+	if baseDelay == 0 {
+		baseDelay = defaultRetryBaseDelay
+	}
+	maxDelay := input.RetryMaxDelay
+	if maxDelay == 0 {
+		maxDelay = defaultRetryMaxDelay
+	}
+
+	return &retryer{
+		DefaultRetryer: client.DefaultRetryer{
+			NumMaxRetries:    maxRetries,
+			MinRetryDelay:    baseDelay,
+			MinThrottleDelay: baseDelay,
+			MaxRetryDelay:    maxDelay,
+			MaxThrottleDelay: maxDelay,
+		},
+	}
+}
+
+// retryer extends the SDK's DefaultRetryer so that plain RequestErrors
+// (e.g. connection resets) are retried in addition to the throttling/5xx
+// cases the default retryer already handles.
+type retryer struct {
+	client.DefaultRetryer
+}
+
+func (r *retryer) ShouldRetry(req *request.Request) bool {
+	if req.Error != nil {
+		if _, ok := req.Error.(*request.RequestError); ok {
+			return true
+		}
+	}
+	return r.DefaultRetryer.ShouldRetry(req)
+}
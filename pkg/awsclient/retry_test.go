@@ -0,0 +1,52 @@
+package awsclient
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+func TestRetryerRetriesThrottling(t *testing.T) {
+	r := newRetryer(NewAwsClientInput{AwsRegion: "us-east-1", MaxRetries: 3})
+
+	req := &request.Request{
+		Error: awserr.New("Throttling", "Rate exceeded", nil),
+	}
+	if !r.ShouldRetry(req) {
+		t.Error("expected ShouldRetry to be true for a Throttling error")
+	}
+}
+
+func TestRetryerRetriesRequestError(t *testing.T) {
+	r := newRetryer(NewAwsClientInput{AwsRegion: "us-east-1", MaxRetries: 3})
+
+	req := &request.Request{
+		Error: &request.RequestError{Error: awserr.New("RequestError", "connection reset", nil)},
+	}
+	if !r.ShouldRetry(req) {
+		t.Error("expected ShouldRetry to be true for a RequestError")
+	}
+}
+
+func TestRetryerDefaultsAppliedWhenUnset(t *testing.T) {
+	r := newRetryer(NewAwsClientInput{AwsRegion: "us-east-1"})
+	rt := r.(*retryer)
+	if rt.NumMaxRetries != defaultMaxRetries {
+		t.Errorf("expected default max retries %d, got %d", defaultMaxRetries, rt.NumMaxRetries)
+	}
+}
+
+func TestRetryerHonorsConfiguredMaxRetries(t *testing.T) {
+	r := newRetryer(NewAwsClientInput{
+		AwsRegion:      "us-east-1",
+		MaxRetries:     7,
+		RetryBaseDelay: 50 * time.Millisecond,
+		RetryMaxDelay:  time.Second,
+	})
+	rt := r.(*retryer)
+	if rt.NumMaxRetries != 7 {
+		t.Errorf("expected configured max retries 7, got %d", rt.NumMaxRetries)
+	}
+}
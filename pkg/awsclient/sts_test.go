@@ -0,0 +1,28 @@
+package awsclient
+
+import (
+	"os"
+	"testing"
+)
+
+func TestGetAWSClientPrefersIRSAWhenTokenFileSet(t *testing.T) {
+	tokenFile := "/var/run/secrets/eks.amazonaws.com/serviceaccount/token"
+	os.Setenv("AWS_WEB_IDENTITY_TOKEN_FILE", tokenFile)
+	os.Setenv("AWS_ROLE_ARN", "arn:aws:iam::123456789012:role/cloud-ingress-operator")
+	defer os.Unsetenv("AWS_WEB_IDENTITY_TOKEN_FILE")
+	defer os.Unsetenv("AWS_ROLE_ARN")
+
+	c, err := GetAWSClient(nil, NewAwsClientInput{AwsRegion: "us-east-1"})
+	if err != nil {
+		t.Fatalf("GetAWSClient returned error: %v", err)
+	}
+	if c == nil {
+		t.Fatal("expected a non-nil client from the IRSA path")
+	}
+}
+
+func TestGetAWSClientRequiresRegion(t *testing.T) {
+	if _, err := GetAWSClient(nil, NewAwsClientInput{}); err == nil {
+		t.Fatal("expected error when AwsRegion is unset")
+	}
+}
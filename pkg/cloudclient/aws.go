@@ -0,0 +1,105 @@
+package cloudclient
+
+import (
+	"fmt"
+
+	"github.com/openshift/cloud-ingress-operator/pkg/awsclient"
+	kubeclientpkg "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// awsCloudClient adapts the existing awsclient.Client (which speaks in raw
+// elbv2/route53 types) to the neutral cloudclient.Client interface.
+type awsCloudClient struct {
+	client awsclient.Client
+}
+
+func newAWSClient(kubeClient kubeclientpkg.Client, input NewClientInput) (Client, error) {
+	awsClient, err := awsclient.GetAWSClient(kubeClient, awsclient.NewAwsClientInput{
+		AwsRegion:  input.Region,
+		SecretName: input.SecretName,
+		NameSpace:  input.NameSpace,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("newAWSClient: %w", err)
+	}
+	return &awsCloudClient{client: awsClient}, nil
+}
+
+func (a *awsCloudClient) ListLoadBalancers() ([]LoadBalancer, error) {
+	nlbs, err := a.client.ListAllNLBs()
+	if err != nil {
+		return nil, err
+	}
+	lbs := make([]LoadBalancer, 0, len(nlbs))
+	for _, nlb := range nlbs {
+		lbs = append(lbs, LoadBalancer{
+			Name:            nlb.Name,
+			DNSName:         nlb.DNSName,
+			CanonicalZoneID: nlb.CanonicalHostedZoneNameID,
+			Scheme:          nlb.Scheme,
+		})
+	}
+	return lbs, nil
+}
+
+func (a *awsCloudClient) GetLoadBalancer(name string) (*LoadBalancer, error) {
+	exists, awsLB, err := a.client.DoesELBExist(name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, fmt.Errorf("getLoadBalancer: %s not found", name)
+	}
+	return &LoadBalancer{
+		Name:            awsLB.ResourceName,
+		DNSName:         awsLB.DNSName,
+		CanonicalZoneID: awsLB.CanonicalHostedZoneNameID,
+	}, nil
+}
+
+func (a *awsCloudClient) CreateLoadBalancer(name string, scheme string, subnets []string) (*LoadBalancer, error) {
+	if len(subnets) == 0 {
+		return nil, fmt.Errorf("createLoadBalancer: at least one subnet is required")
+	}
+	nlbs, err := a.client.CreateNetworkLoadBalancer(name, scheme, subnets[0])
+	if err != nil {
+		return nil, err
+	}
+	if len(nlbs) == 0 {
+		return nil, fmt.Errorf("createLoadBalancer: no load balancer returned for %s", name)
+	}
+	return &LoadBalancer{
+		Name:            nlbs[0].Name,
+		DNSName:         nlbs[0].DNSName,
+		CanonicalZoneID: nlbs[0].CanonicalHostedZoneNameID,
+		Scheme:          nlbs[0].Scheme,
+	}, nil
+}
+
+func (a *awsCloudClient) DeleteLoadBalancer(name string) error {
+	return a.client.DeleteExternalLoadBalancer(name)
+}
+
+func (a *awsCloudClient) CreateTargetGroup(name string, port int64) (*TargetGroup, error) {
+	arn, err := a.client.GetTargetGroupArn(name)
+	if err != nil {
+		return nil, err
+	}
+	return &TargetGroup{Name: arn, Port: port}, nil
+}
+
+func (a *awsCloudClient) RegisterTargets(targetGroupName string, targets []string) error {
+	return fmt.Errorf("registerTargets: not yet implemented for AWS via cloudclient, use awsclient.Client.RegisterTargetsV2 directly")
+}
+
+func (a *awsCloudClient) UpsertDNSRecord(zoneName string, record DNSRecordSet) error {
+	target := record.AliasTarget
+	if !record.Alias && len(record.Targets) > 0 {
+		target = record.Targets[0]
+	}
+	return a.client.UpsertARecord(zoneName, target, "", "", record.Name, record.Alias)
+}
+
+func (a *awsCloudClient) SubnetNameToSubnetIDLookup(names []string) ([]string, error) {
+	return a.client.SubnetNameToSubnetIDLookup(names, nil)
+}
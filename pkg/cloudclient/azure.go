@@ -0,0 +1,239 @@
+package cloudclient
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/dns/mgmt/2018-05-01/dns"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-05-01/network"
+	"github.com/Azure/go-autorest/autorest/azure/auth"
+	"github.com/Azure/go-autorest/autorest/to"
+	kubeclientpkg "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// azureCloudClient implements Client on top of Azure Load Balancers (for the
+// NLB-equivalent) and Azure DNS (for the Route53-equivalent).
+type azureCloudClient struct {
+	subscriptionID string
+	resourceGroup  string
+	vnetName       string
+	lbClient       network.LoadBalancersClient
+	backendClient  network.LoadBalancerBackendAddressPoolsClient
+	subnetsClient  network.SubnetsClient
+	recordClient   dns.RecordSetsClient
+}
+
+func newAzureClient(kubeClient kubeclientpkg.Client, input NewClientInput) (Client, error) {
+	if input.SubscriptionID == "" || input.ResourceGroup == "" {
+		return nil, fmt.Errorf("newAzureClient: SubscriptionID and ResourceGroup are required")
+	}
+
+	authorizer, err := auth.NewAuthorizerFromEnvironment()
+	if err != nil {
+		return nil, fmt.Errorf("newAzureClient: failed to build authorizer: %w", err)
+	}
+
+	lbClient := network.NewLoadBalancersClient(input.SubscriptionID)
+	lbClient.Authorizer = authorizer
+
+	backendClient := network.NewLoadBalancerBackendAddressPoolsClient(input.SubscriptionID)
+	backendClient.Authorizer = authorizer
+
+	subnetsClient := network.NewSubnetsClient(input.SubscriptionID)
+	subnetsClient.Authorizer = authorizer
+
+	recordClient := dns.NewRecordSetsClient(input.SubscriptionID)
+	recordClient.Authorizer = authorizer
+
+	return &azureCloudClient{
+		subscriptionID: input.SubscriptionID,
+		resourceGroup:  input.ResourceGroup,
+		vnetName:       input.VNetName,
+		lbClient:       lbClient,
+		backendClient:  backendClient,
+		subnetsClient:  subnetsClient,
+		recordClient:   recordClient,
+	}, nil
+}
+
+func (a *azureCloudClient) ListLoadBalancers() ([]LoadBalancer, error) {
+	ctx := context.TODO()
+	page, err := a.lbClient.List(ctx, a.resourceGroup)
+	if err != nil {
+		return nil, fmt.Errorf("listLoadBalancers: %w", err)
+	}
+	var lbs []LoadBalancer
+	for page.NotDone() {
+		for _, lb := range page.Values() {
+			lbs = append(lbs, LoadBalancer{Name: *lb.Name})
+		}
+		if err := page.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("listLoadBalancers: %w", err)
+		}
+	}
+	return lbs, nil
+}
+
+func (a *azureCloudClient) GetLoadBalancer(name string) (*LoadBalancer, error) {
+	ctx := context.TODO()
+	lb, err := a.lbClient.Get(ctx, a.resourceGroup, name, "")
+	if err != nil {
+		return nil, fmt.Errorf("getLoadBalancer: %w", err)
+	}
+	return &LoadBalancer{Name: *lb.Name}, nil
+}
+
+// CreateLoadBalancer creates a Standard-SKU load balancer with a frontend IP
+// configuration per subnet, each taking a private IP from its subnet
+// (subnets are expected to be fully-qualified subnet resource IDs, the same
+// convention used for AWS subnet IDs elsewhere in this package).
+func (a *azureCloudClient) CreateLoadBalancer(name string, scheme string, subnets []string) (*LoadBalancer, error) {
+	if len(subnets) == 0 {
+		return nil, fmt.Errorf("createLoadBalancer: at least one subnet is required")
+	}
+	ctx := context.TODO()
+
+	feConfigs := make([]network.FrontendIPConfiguration, 0, len(subnets))
+	for i, subnetID := range subnets {
+		subnetID := subnetID
+		feConfigs = append(feConfigs, network.FrontendIPConfiguration{
+			Name: to.StringPtr(fmt.Sprintf("%s-frontend-%d", name, i)),
+			FrontendIPConfigurationPropertiesFormat: &network.FrontendIPConfigurationPropertiesFormat{
+				Subnet:                    &network.Subnet{ID: &subnetID},
+				PrivateIPAllocationMethod: network.Dynamic,
+			},
+		})
+	}
+
+	future, err := a.lbClient.CreateOrUpdate(ctx, a.resourceGroup, name, network.LoadBalancer{
+		Name: &name,
+		Sku:  &network.LoadBalancerSku{Name: network.LoadBalancerSkuNameStandard},
+		LoadBalancerPropertiesFormat: &network.LoadBalancerPropertiesFormat{
+			FrontendIPConfigurations: &feConfigs,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("createLoadBalancer: %w", err)
+	}
+	if err := future.WaitForCompletionRef(ctx, a.lbClient.Client); err != nil {
+		return nil, fmt.Errorf("createLoadBalancer: waiting for completion: %w", err)
+	}
+	return &LoadBalancer{Name: name, Scheme: scheme, Subnets: subnets}, nil
+}
+
+func (a *azureCloudClient) DeleteLoadBalancer(name string) error {
+	ctx := context.TODO()
+	future, err := a.lbClient.Delete(ctx, a.resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("deleteLoadBalancer: %w", err)
+	}
+	return future.WaitForCompletionRef(ctx, a.lbClient.Client)
+}
+
+// CreateTargetGroup creates a backend address pool named name on the load
+// balancer named name (the same name convention CreateLoadBalancer uses),
+// since Azure backend pools are sub-resources of a load balancer rather
+// than a standalone object like an AWS target group or GCP target pool.
+func (a *azureCloudClient) CreateTargetGroup(name string, port int64) (*TargetGroup, error) {
+	ctx := context.TODO()
+	future, err := a.backendClient.CreateOrUpdate(ctx, a.resourceGroup, name, name, network.BackendAddressPool{})
+	if err != nil {
+		return nil, fmt.Errorf("createTargetGroup: %w", err)
+	}
+	if err := future.WaitForCompletionRef(ctx, a.backendClient.Client); err != nil {
+		return nil, fmt.Errorf("createTargetGroup: waiting for completion: %w", err)
+	}
+	return &TargetGroup{Name: name, Port: port}, nil
+}
+
+// RegisterTargets registers targets (IP addresses) as an IP-based backend
+// pool on the load balancer/backend pool pair named targetGroupName.
+func (a *azureCloudClient) RegisterTargets(targetGroupName string, targets []string) error {
+	ctx := context.TODO()
+	addresses := make([]network.LoadBalancerBackendAddress, 0, len(targets))
+	for i, t := range targets {
+		t := t
+		addresses = append(addresses, network.LoadBalancerBackendAddress{
+			Name: to.StringPtr(fmt.Sprintf("%s-target-%d", targetGroupName, i)),
+			LoadBalancerBackendAddressPropertiesFormat: &network.LoadBalancerBackendAddressPropertiesFormat{
+				IPAddress: &t,
+			},
+		})
+	}
+
+	future, err := a.backendClient.CreateOrUpdate(ctx, a.resourceGroup, targetGroupName, targetGroupName, network.BackendAddressPool{
+		BackendAddressPoolPropertiesFormat: &network.BackendAddressPoolPropertiesFormat{
+			LoadBalancerBackendAddresses: &addresses,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("registerTargets: %w", err)
+	}
+	return future.WaitForCompletionRef(ctx, a.backendClient.Client)
+}
+
+func (a *azureCloudClient) UpsertDNSRecord(zoneName string, record DNSRecordSet) error {
+	ctx := context.TODO()
+	recordType := dns.A
+	props := &dns.RecordSetProperties{
+		TTL: &record.TTL,
+	}
+	if record.Alias {
+		props.TargetResource = &dns.SubResource{ID: &record.AliasTarget}
+	} else {
+		arecords := make([]dns.ARecord, 0, len(record.Targets))
+		for _, t := range record.Targets {
+			t := t
+			arecords = append(arecords, dns.ARecord{Ipv4Address: &t})
+		}
+		props.ARecords = &arecords
+	}
+
+	_, err := a.recordClient.CreateOrUpdate(ctx, a.resourceGroup, zoneName, record.Name, recordType, dns.RecordSet{
+		RecordSetProperties: props,
+	}, "", "")
+	if err != nil {
+		return fmt.Errorf("upsertDNSRecord: %w", err)
+	}
+	return nil
+}
+
+// SubnetNameToSubnetIDLookup resolves subnet names to their resource IDs
+// within the operator's configured VNet (NewClientInput.VNetName).
+func (a *azureCloudClient) SubnetNameToSubnetIDLookup(names []string) ([]string, error) {
+	if a.vnetName == "" {
+		return nil, fmt.Errorf("subnetNameToSubnetIDLookup: no VNetName configured")
+	}
+	ctx := context.TODO()
+	wanted := make(map[string]bool, len(names))
+	for _, n := range names {
+		wanted[n] = true
+	}
+
+	page, err := a.subnetsClient.List(ctx, a.resourceGroup, a.vnetName)
+	if err != nil {
+		return nil, fmt.Errorf("subnetNameToSubnetIDLookup: %w", err)
+	}
+
+	found := make(map[string]bool, len(names))
+	var ids []string
+	for page.NotDone() {
+		for _, subnet := range page.Values() {
+			name := to.String(subnet.Name)
+			if wanted[name] {
+				found[name] = true
+				ids = append(ids, to.String(subnet.ID))
+			}
+		}
+		if err := page.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("subnetNameToSubnetIDLookup: %w", err)
+		}
+	}
+
+	for _, name := range names {
+		if !found[name] {
+			return nil, fmt.Errorf("subnetNameToSubnetIDLookup: no subnet named %q in VNet %s", name, a.vnetName)
+		}
+	}
+	return ids, nil
+}
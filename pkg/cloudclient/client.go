@@ -0,0 +1,77 @@
+// Package cloudclient provides a provider-agnostic abstraction over the
+// cloud APIs cloud-ingress-operator needs (load balancers, DNS, subnets),
+// so that the reconcile logic in the rest of the operator does not need to
+// know whether it is running on AWS, GCP, or Azure.
+package cloudclient
+
+import (
+	"context"
+	"fmt"
+
+	configv1 "github.com/openshift/api/config/v1"
+	kubeclientpkg "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Client is the provider-neutral surface the operator's internal/external
+// API-swap logic is written against. Each supported platform has its own
+// implementation underneath.
+type Client interface {
+	// load balancers
+	ListLoadBalancers() ([]LoadBalancer, error)
+	GetLoadBalancer(name string) (*LoadBalancer, error)
+	CreateLoadBalancer(name string, scheme string, subnets []string) (*LoadBalancer, error)
+	DeleteLoadBalancer(name string) error
+
+	// target groups
+	CreateTargetGroup(name string, port int64) (*TargetGroup, error)
+	RegisterTargets(targetGroupName string, targets []string) error
+
+	// DNS
+	UpsertDNSRecord(zoneName string, record DNSRecordSet) error
+
+	// subnets
+	SubnetNameToSubnetIDLookup(names []string) ([]string, error)
+}
+
+// NewClientInput carries the configuration needed to build any of the
+// per-platform clients. Only the fields relevant to the detected platform
+// need to be populated.
+type NewClientInput struct {
+	Region     string
+	SecretName string
+	NameSpace  string
+
+	// GCP
+	ProjectID string
+
+	// Azure
+	SubscriptionID string
+	ResourceGroup  string
+	VNetName       string
+}
+
+// GetCloudClient detects the platform from the cluster's Infrastructure
+// object and returns the matching Client implementation. This is the single
+// entry point the rest of the operator should use instead of reaching for a
+// specific provider's client directly.
+func GetCloudClient(kubeClient kubeclientpkg.Client, input NewClientInput) (Client, error) {
+	infra := &configv1.Infrastructure{}
+	if err := kubeClient.Get(context.TODO(), kubeclientpkg.ObjectKey{Name: "cluster"}, infra); err != nil {
+		return nil, fmt.Errorf("getCloudClient: failed to get Infrastructure/cluster: %w", err)
+	}
+
+	if infra.Status.PlatformStatus == nil {
+		return nil, fmt.Errorf("getCloudClient: Infrastructure/cluster has no PlatformStatus")
+	}
+
+	switch infra.Status.PlatformStatus.Type {
+	case configv1.AWSPlatformType:
+		return newAWSClient(kubeClient, input)
+	case configv1.GCPPlatformType:
+		return newGCPClient(kubeClient, input)
+	case configv1.AzurePlatformType:
+		return newAzureClient(kubeClient, input)
+	default:
+		return nil, fmt.Errorf("getCloudClient: unsupported platform %q", infra.Status.PlatformStatus.Type)
+	}
+}
@@ -0,0 +1,85 @@
+package cloudclient_test
+
+import (
+	"testing"
+
+	"github.com/openshift/cloud-ingress-operator/pkg/cloudclient"
+	"github.com/openshift/cloud-ingress-operator/pkg/cloudclient/fake"
+)
+
+func TestFakeCloudClientCreateAndListLoadBalancer(t *testing.T) {
+	c := fake.NewCloudClient()
+
+	lb, err := c.CreateLoadBalancer("rh-api", "internet-facing", []string{"subnet-a", "subnet-b"})
+	if err != nil {
+		t.Fatalf("CreateLoadBalancer returned error: %v", err)
+	}
+	if lb.Name != "rh-api" {
+		t.Errorf("expected load balancer name rh-api, got %s", lb.Name)
+	}
+
+	lbs, err := c.ListLoadBalancers()
+	if err != nil {
+		t.Fatalf("ListLoadBalancers returned error: %v", err)
+	}
+	if len(lbs) != 1 {
+		t.Fatalf("expected 1 load balancer, got %d", len(lbs))
+	}
+}
+
+func TestFakeCloudClientRegisterTargetsRequiresTargetGroup(t *testing.T) {
+	c := fake.NewCloudClient()
+
+	if err := c.RegisterTargets("missing-tg", []string{"i-1"}); err == nil {
+		t.Fatal("expected error registering targets against a nonexistent target group")
+	}
+
+	if _, err := c.CreateTargetGroup("masters", 6443); err != nil {
+		t.Fatalf("CreateTargetGroup returned error: %v", err)
+	}
+	if err := c.RegisterTargets("masters", []string{"i-1", "i-2"}); err != nil {
+		t.Fatalf("RegisterTargets returned error: %v", err)
+	}
+	if len(c.RegisteredTargets["masters"]) != 2 {
+		t.Errorf("expected 2 registered targets, got %d", len(c.RegisteredTargets["masters"]))
+	}
+}
+
+func TestFakeCloudClientUpsertDNSRecordAlias(t *testing.T) {
+	c := fake.NewCloudClient()
+
+	record := cloudclient.DNSRecordSet{
+		Name:        "rh-api",
+		Type:        "A",
+		Alias:       true,
+		AliasTarget: "rh-api-nlb.fake.example.com",
+	}
+	if err := c.UpsertDNSRecord("example.com.", record); err != nil {
+		t.Fatalf("UpsertDNSRecord returned error: %v", err)
+	}
+
+	stored, ok := c.DNSRecords["example.com./rh-api"]
+	if !ok {
+		t.Fatal("expected DNS record to be stored")
+	}
+	if !stored.Alias || stored.AliasTarget != record.AliasTarget {
+		t.Errorf("stored DNS record does not match alias target: %+v", stored)
+	}
+}
+
+func TestFakeCloudClientSubnetNameToSubnetIDLookup(t *testing.T) {
+	c := fake.NewCloudClient()
+	c.Subnets["subnet-a"] = "subnet-12345"
+
+	ids, err := c.SubnetNameToSubnetIDLookup([]string{"subnet-a"})
+	if err != nil {
+		t.Fatalf("SubnetNameToSubnetIDLookup returned error: %v", err)
+	}
+	if len(ids) != 1 || ids[0] != "subnet-12345" {
+		t.Errorf("unexpected subnet ids: %v", ids)
+	}
+
+	if _, err := c.SubnetNameToSubnetIDLookup([]string{"subnet-unknown"}); err == nil {
+		t.Fatal("expected error for unknown subnet name")
+	}
+}
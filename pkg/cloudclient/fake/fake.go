@@ -0,0 +1,101 @@
+// Package fake provides an in-memory cloudclient.Client, modeled after the
+// FakeAWSServices pattern used by Kubernetes' AWS cloud provider tests, so
+// that reconcile logic can be exercised without talking to a real cloud
+// API.
+package fake
+
+import (
+	"fmt"
+
+	"github.com/openshift/cloud-ingress-operator/pkg/cloudclient"
+)
+
+// CloudClient is a fake, in-memory implementation of cloudclient.Client.
+// Tests can pre-seed it and then assert on the calls it recorded.
+type CloudClient struct {
+	LoadBalancers map[string]cloudclient.LoadBalancer
+	TargetGroups  map[string]cloudclient.TargetGroup
+	DNSRecords    map[string]cloudclient.DNSRecordSet
+	Subnets       map[string]string // name -> id
+
+	// RegisteredTargets records every RegisterTargets call, keyed by
+	// target group name.
+	RegisteredTargets map[string][]string
+}
+
+// NewCloudClient returns an empty fake, ready to be seeded by a test.
+func NewCloudClient() *CloudClient {
+	return &CloudClient{
+		LoadBalancers:     map[string]cloudclient.LoadBalancer{},
+		TargetGroups:      map[string]cloudclient.TargetGroup{},
+		DNSRecords:        map[string]cloudclient.DNSRecordSet{},
+		Subnets:           map[string]string{},
+		RegisteredTargets: map[string][]string{},
+	}
+}
+
+func (f *CloudClient) ListLoadBalancers() ([]cloudclient.LoadBalancer, error) {
+	lbs := make([]cloudclient.LoadBalancer, 0, len(f.LoadBalancers))
+	for _, lb := range f.LoadBalancers {
+		lbs = append(lbs, lb)
+	}
+	return lbs, nil
+}
+
+func (f *CloudClient) GetLoadBalancer(name string) (*cloudclient.LoadBalancer, error) {
+	lb, ok := f.LoadBalancers[name]
+	if !ok {
+		return nil, fmt.Errorf("fake: load balancer %s not found", name)
+	}
+	return &lb, nil
+}
+
+func (f *CloudClient) CreateLoadBalancer(name string, scheme string, subnets []string) (*cloudclient.LoadBalancer, error) {
+	lb := cloudclient.LoadBalancer{
+		Name:    name,
+		DNSName: fmt.Sprintf("%s.fake.example.com", name),
+		Scheme:  scheme,
+		Subnets: subnets,
+	}
+	f.LoadBalancers[name] = lb
+	return &lb, nil
+}
+
+func (f *CloudClient) DeleteLoadBalancer(name string) error {
+	if _, ok := f.LoadBalancers[name]; !ok {
+		return fmt.Errorf("fake: load balancer %s not found", name)
+	}
+	delete(f.LoadBalancers, name)
+	return nil
+}
+
+func (f *CloudClient) CreateTargetGroup(name string, port int64) (*cloudclient.TargetGroup, error) {
+	tg := cloudclient.TargetGroup{Name: name, Port: port}
+	f.TargetGroups[name] = tg
+	return &tg, nil
+}
+
+func (f *CloudClient) RegisterTargets(targetGroupName string, targets []string) error {
+	if _, ok := f.TargetGroups[targetGroupName]; !ok {
+		return fmt.Errorf("fake: target group %s not found", targetGroupName)
+	}
+	f.RegisteredTargets[targetGroupName] = append(f.RegisteredTargets[targetGroupName], targets...)
+	return nil
+}
+
+func (f *CloudClient) UpsertDNSRecord(zoneName string, record cloudclient.DNSRecordSet) error {
+	f.DNSRecords[zoneName+"/"+record.Name] = record
+	return nil
+}
+
+func (f *CloudClient) SubnetNameToSubnetIDLookup(names []string) ([]string, error) {
+	ids := make([]string, 0, len(names))
+	for _, name := range names {
+		id, ok := f.Subnets[name]
+		if !ok {
+			return nil, fmt.Errorf("fake: subnet %s not found", name)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
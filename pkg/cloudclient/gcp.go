@@ -0,0 +1,245 @@
+package cloudclient
+
+import (
+	"context"
+	"fmt"
+
+	compute "cloud.google.com/go/compute/apiv1"
+	computepb "cloud.google.com/go/compute/apiv1/computepb"
+	dns "google.golang.org/api/dns/v1"
+	"google.golang.org/api/iterator"
+	kubeclientpkg "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// gcpCloudClient implements Client on top of GCP Forwarding Rules (for the
+// NLB-equivalent) and Cloud DNS (for the Route53-equivalent).
+type gcpCloudClient struct {
+	projectID       string
+	region          string
+	forwardingRules *compute.ForwardingRulesClient
+	targetPools     *compute.TargetPoolsClient
+	subnetworks     *compute.SubnetworksClient
+	dnsService      *dns.Service
+}
+
+func newGCPClient(kubeClient kubeclientpkg.Client, input NewClientInput) (Client, error) {
+	ctx := context.TODO()
+
+	if input.ProjectID == "" {
+		return nil, fmt.Errorf("newGCPClient: ProjectID is required")
+	}
+
+	frClient, err := compute.NewForwardingRulesRESTClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("newGCPClient: failed to create forwarding rules client: %w", err)
+	}
+	tpClient, err := compute.NewTargetPoolsRESTClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("newGCPClient: failed to create target pools client: %w", err)
+	}
+	subnetClient, err := compute.NewSubnetworksRESTClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("newGCPClient: failed to create subnetworks client: %w", err)
+	}
+	dnsService, err := dns.NewService(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("newGCPClient: failed to create Cloud DNS client: %w", err)
+	}
+
+	return &gcpCloudClient{
+		projectID:       input.ProjectID,
+		region:          input.Region,
+		forwardingRules: frClient,
+		targetPools:     tpClient,
+		subnetworks:     subnetClient,
+		dnsService:      dnsService,
+	}, nil
+}
+
+func (g *gcpCloudClient) ListLoadBalancers() ([]LoadBalancer, error) {
+	ctx := context.TODO()
+	it := g.forwardingRules.List(ctx, &computepb.ListForwardingRulesRequest{
+		Project: g.projectID,
+		Region:  g.region,
+	})
+	var lbs []LoadBalancer
+	for {
+		rule, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("listLoadBalancers: %w", err)
+		}
+		lbs = append(lbs, LoadBalancer{
+			Name:    rule.GetName(),
+			DNSName: rule.GetIPAddress(),
+			Scheme:  rule.GetLoadBalancingScheme(),
+		})
+	}
+	return lbs, nil
+}
+
+func (g *gcpCloudClient) GetLoadBalancer(name string) (*LoadBalancer, error) {
+	ctx := context.TODO()
+	rule, err := g.forwardingRules.Get(ctx, &computepb.GetForwardingRuleRequest{
+		Project:        g.projectID,
+		Region:         g.region,
+		ForwardingRule: name,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("getLoadBalancer: %w", err)
+	}
+	return &LoadBalancer{
+		Name:    rule.GetName(),
+		DNSName: rule.GetIPAddress(),
+		Scheme:  rule.GetLoadBalancingScheme(),
+	}, nil
+}
+
+// CreateLoadBalancer creates a regional forwarding rule targeting the target
+// pool of the same name (see CreateTargetGroup), which must already exist:
+// GCP forwarding rules require a target at creation time, unlike an AWS NLB
+// or Azure load balancer. For an INTERNAL-scheme (internal-facing) load
+// balancer, subnets[0] is attached as the rule's subnetwork.
+func (g *gcpCloudClient) CreateLoadBalancer(name string, scheme string, subnets []string) (*LoadBalancer, error) {
+	if len(subnets) == 0 {
+		return nil, fmt.Errorf("createLoadBalancer: at least one subnet is required")
+	}
+	ctx := context.TODO()
+
+	target := fmt.Sprintf("projects/%s/regions/%s/targetPools/%s", g.projectID, g.region, name)
+	rule := &computepb.ForwardingRule{
+		Name:                &name,
+		LoadBalancingScheme: &scheme,
+		Target:              &target,
+	}
+	if scheme == "INTERNAL" {
+		subnetwork := fmt.Sprintf("projects/%s/regions/%s/subnetworks/%s", g.projectID, g.region, subnets[0])
+		rule.Subnetwork = &subnetwork
+	}
+
+	op, err := g.forwardingRules.Insert(ctx, &computepb.InsertForwardingRuleRequest{
+		Project:                g.projectID,
+		Region:                 g.region,
+		ForwardingRuleResource: rule,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("createLoadBalancer: %w", err)
+	}
+	if err := op.Wait(ctx); err != nil {
+		return nil, fmt.Errorf("createLoadBalancer: waiting for operation: %w", err)
+	}
+	return g.GetLoadBalancer(name)
+}
+
+func (g *gcpCloudClient) DeleteLoadBalancer(name string) error {
+	ctx := context.TODO()
+	op, err := g.forwardingRules.Delete(ctx, &computepb.DeleteForwardingRuleRequest{
+		Project:        g.projectID,
+		Region:         g.region,
+		ForwardingRule: name,
+	})
+	if err != nil {
+		return fmt.Errorf("deleteLoadBalancer: %w", err)
+	}
+	return op.Wait(ctx)
+}
+
+func (g *gcpCloudClient) CreateTargetGroup(name string, port int64) (*TargetGroup, error) {
+	ctx := context.TODO()
+	op, err := g.targetPools.Insert(ctx, &computepb.InsertTargetPoolRequest{
+		Project: g.projectID,
+		Region:  g.region,
+		TargetPoolResource: &computepb.TargetPool{
+			Name: &name,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("createTargetGroup: %w", err)
+	}
+	if err := op.Wait(ctx); err != nil {
+		return nil, fmt.Errorf("createTargetGroup: waiting for operation: %w", err)
+	}
+	return &TargetGroup{Name: name, Port: port}, nil
+}
+
+func (g *gcpCloudClient) RegisterTargets(targetGroupName string, targets []string) error {
+	ctx := context.TODO()
+	instances := make([]*computepb.InstanceReference, 0, len(targets))
+	for _, t := range targets {
+		t := t
+		instances = append(instances, &computepb.InstanceReference{Instance: &t})
+	}
+	op, err := g.targetPools.AddInstance(ctx, &computepb.AddInstanceTargetPoolRequest{
+		Project:    g.projectID,
+		Region:     g.region,
+		TargetPool: targetGroupName,
+		TargetPoolsAddInstanceRequestResource: &computepb.TargetPoolsAddInstanceRequest{
+			Instances: instances,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("registerTargets: %w", err)
+	}
+	return op.Wait(ctx)
+}
+
+// UpsertDNSRecord mirrors Route53's upsert semantics on top of Cloud DNS,
+// which has no native upsert: a Change replacing an existing RRset must list
+// it under Deletions alongside the new RRset under Additions, or the API
+// rejects the Change as a duplicate record. So the existing RRset (if any)
+// is looked up first.
+func (g *gcpCloudClient) UpsertDNSRecord(zoneName string, record DNSRecordSet) error {
+	rrset := &dns.ResourceRecordSet{
+		Name: record.Name,
+		Type: record.Type,
+		Ttl:  record.TTL,
+	}
+	if record.Alias {
+		rrset.Rrdatas = []string{record.AliasTarget}
+	} else {
+		rrset.Rrdatas = record.Targets
+	}
+
+	existing, err := g.dnsService.ResourceRecordSets.List(g.projectID, zoneName).Name(record.Name).Type(record.Type).Do()
+	if err != nil {
+		return fmt.Errorf("upsertDNSRecord: listing existing record: %w", err)
+	}
+
+	change := &dns.Change{
+		Additions: []*dns.ResourceRecordSet{rrset},
+		Deletions: existing.Rrsets,
+	}
+	if _, err := g.dnsService.Changes.Create(g.projectID, zoneName, change).Do(); err != nil {
+		return fmt.Errorf("upsertDNSRecord: %w", err)
+	}
+	return nil
+}
+
+func (g *gcpCloudClient) SubnetNameToSubnetIDLookup(names []string) ([]string, error) {
+	ctx := context.TODO()
+	wanted := make(map[string]bool, len(names))
+	for _, n := range names {
+		wanted[n] = true
+	}
+
+	var ids []string
+	it := g.subnetworks.List(ctx, &computepb.ListSubnetworksRequest{
+		Project: g.projectID,
+		Region:  g.region,
+	})
+	for {
+		subnet, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("subnetNameToSubnetIDLookup: %w", err)
+		}
+		if wanted[subnet.GetName()] {
+			ids = append(ids, fmt.Sprintf("%d", subnet.GetId()))
+		}
+	}
+	return ids, nil
+}
@@ -0,0 +1,42 @@
+package cloudclient
+
+// LoadBalancer is a provider-neutral view of a load balancer fronting the
+// management API (or ingress) endpoint.
+type LoadBalancer struct {
+	Name              string
+	DNSName           string
+	CanonicalZoneID   string
+	Scheme            string // the provider's own value: "internet-facing"/"internal" on AWS, "EXTERNAL"/"INTERNAL" on GCP
+	Subnets           []string
+	SecurityGroupIDs  []string
+	AvailabilityZones []string
+}
+
+// TargetGroup is a provider-neutral view of a backend pool that a
+// LoadBalancer forwards traffic to.
+type TargetGroup struct {
+	Name     string
+	Port     int64
+	Protocol string
+	Targets  []string
+}
+
+// DNSRecordSet is a provider-neutral DNS record.
+type DNSRecordSet struct {
+	Name    string
+	Type    string
+	TTL     int64
+	Targets []string
+	// Alias, when set, means Targets is ignored and the record instead
+	// points at AliasTarget (e.g. an AWS ALIAS record or a GCP/Azure
+	// equivalent).
+	Alias       bool
+	AliasTarget string
+}
+
+// Subnet is a provider-neutral view of a subnet/VPC subnetwork.
+type Subnet struct {
+	ID               string
+	Name             string
+	AvailabilityZone string
+}